@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// msgServer wraps an EVMSendKeeper with the bank module's user-facing message
+// handlers. SendEnabled/BlockedAddr gating lives here rather than inside
+// EVMSendKeeper.SendCoins/InputOutputCoins, because those keeper methods are
+// also the path used for internal, non-user-initiated transfers that are
+// deliberately exempt from this gating (fee distribution, IBC escrow
+// movements, refunds to blocked module accounts).
+type msgServer struct {
+	EVMSendKeeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the bank module's MsgServer
+// backed by the provided EVMSendKeeper, for registration with the module's
+// message router alongside the rest of x/bank.
+func NewMsgServerImpl(k EVMSendKeeper) types.MsgServer {
+	return msgServer{EVMSendKeeper: k}
+}
+
+// Send implements the MsgSend handler.
+func (k msgServer) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := k.ak.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(toAddr) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if err := k.SendCoins(ctx, fromAddr, toAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendResponse{}, nil
+}
+
+// MultiSend implements the MsgMultiSend handler.
+func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
+	if len(msg.Inputs) != 1 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("multi-send only supports a single input")
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Inputs[0].Coins...); err != nil {
+		return nil, err
+	}
+
+	for _, out := range msg.Outputs {
+		if err := k.IsSendEnabledCoins(ctx, out.Coins...); err != nil {
+			return nil, err
+		}
+
+		toAddr, err := k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.BlockedAddr(toAddr) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", out.Address)
+		}
+	}
+
+	if err := k.InputOutputCoins(ctx, msg.Inputs[0], msg.Outputs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiSendResponse{}, nil
+}
+
+// SetSendEnabled implements the MsgSetSendEnabled handler. It lets the
+// authority (typically the x/gov module account) freeze or unfreeze transfers
+// for a set of ERC20-backed denoms without a full parameter-change proposal.
+func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := types.ValidateSendEnabled(msg.SendEnabled); err != nil {
+		return nil, err
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetAllSendEnabled(ctx, msg.SendEnabled)
+	k.DeleteSendEnabled(ctx, msg.UseDefaultFor...)
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+// UpdateParams implements the MsgUpdateParams handler. It lets the authority
+// (typically the x/gov module account) update the bank module's parameters
+// in a single governance-gated transaction.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority: expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// These tests are scoped to the checks msgServer performs before it ever
+// touches the account/ERC20 keepers it's constructed with, since those
+// keepers aren't fakeable in this package without real implementations of
+// their interfaces.
+
+func TestMsgServerMultiSendRequiresSingleInput(t *testing.T) {
+	srv := msgServer{}
+
+	_, err := srv.MultiSend(context.Background(), &types.MsgMultiSend{
+		Inputs:  []types.Input{{}, {}},
+		Outputs: []types.Output{{}},
+	})
+	require.Error(t, err)
+}
+
+func TestMsgServerSetSendEnabledRequiresAuthority(t *testing.T) {
+	srv := msgServer{EVMSendKeeper: EVMSendKeeper{authority: "authority"}}
+
+	_, err := srv.SetSendEnabled(context.Background(), &types.MsgSetSendEnabled{
+		Authority: "not-the-authority",
+	})
+	require.Error(t, err)
+}
+
+func TestMsgServerUpdateParamsRequiresAuthority(t *testing.T) {
+	srv := msgServer{EVMSendKeeper: EVMSendKeeper{authority: "authority"}}
+
+	_, err := srv.UpdateParams(context.Background(), &types.MsgUpdateParams{
+		Authority: "not-the-authority",
+	})
+	require.Error(t, err)
+}
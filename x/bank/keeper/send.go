@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/store"
 
@@ -35,6 +36,7 @@ type EVMSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	mintRestriction *mintRestriction
 }
 
 func NewEVMSendKeeper(
@@ -44,11 +46,15 @@ func NewEVMSendKeeper(
 	ek evmtypes.IERC20Keeper,
 	blockedAddrs map[string]bool,
 	authority string,
+	restrictionsOrder []string,
 ) EVMSendKeeper {
 	if _, err := ak.AddressCodec().StringToBytes(authority); err != nil {
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
+	sendRestriction := newSendRestriction()
+	sendRestriction.setOrder(restrictionsOrder)
+
 	return EVMSendKeeper{
 		EVMViewKeeper:   NewEVMViewKeeper(cdc, storeService, ak, ek),
 		cdc:             cdc,
@@ -56,10 +62,38 @@ func NewEVMSendKeeper(
 		storeService:    storeService,
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
-		sendRestriction: newSendRestriction(),
+		sendRestriction: sendRestriction,
+		mintRestriction: newMintRestriction(),
 	}
 }
 
+// RegisterSendRestriction adds fn to the registry under name, replacing any
+// restriction previously registered under the same name. Restrictions are
+// applied in the order configured via SetSendRestrictionOrder (typically
+// surfaced through the module's restrictions_order depinject config); when no
+// order is configured, they're applied in alphabetical order of their names.
+func (k EVMSendKeeper) RegisterSendRestriction(name string, fn types.SendRestrictionFn) {
+	k.sendRestriction.register(name, fn)
+}
+
+// SetSendRestrictionOrder sets the explicit order in which registered send
+// restrictions are applied. Passing nil restores the default: alphabetical
+// order of registered names.
+//
+// NewEVMSendKeeper already takes a restrictionsOrder argument fed from the
+// app's restrictions_order config at construction time; this method exists
+// for callers that need to change the order afterwards, e.g. in response to
+// a governance parameter change.
+func (k EVMSendKeeper) SetSendRestrictionOrder(order []string) {
+	k.sendRestriction.setOrder(order)
+}
+
+// ListSendRestrictions returns the names of all registered send restrictions,
+// in the order they are applied.
+func (k EVMSendKeeper) ListSendRestrictions() []string {
+	return k.sendRestriction.list()
+}
+
 // AppendSendRestriction adds the provided SendRestrictionFn to run after previously provided restrictions.
 func (k EVMSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
 	k.sendRestriction.append(restriction)
@@ -70,11 +104,47 @@ func (k EVMSendKeeper) PrependSendRestriction(restriction types.SendRestrictionF
 	k.sendRestriction.prepend(restriction)
 }
 
-// ClearSendRestriction removes the send restriction (if there is one).
+// ClearSendRestriction removes all registered send restrictions.
 func (k EVMSendKeeper) ClearSendRestriction() {
 	k.sendRestriction.clear()
 }
 
+// RegisterMintRestriction adds fn to the registry under name, replacing any
+// restriction previously registered under the same name. Restrictions are
+// applied in the order configured via SetMintRestrictionOrder; when no order
+// is configured, they're applied in alphabetical order of their names.
+func (k EVMSendKeeper) RegisterMintRestriction(name string, fn MintRestrictionFn) {
+	k.mintRestriction.register(name, fn)
+}
+
+// SetMintRestrictionOrder sets the explicit order in which registered mint
+// restrictions are applied. Passing nil restores the default: alphabetical
+// order of registered names.
+func (k EVMSendKeeper) SetMintRestrictionOrder(order []string) {
+	k.mintRestriction.setOrder(order)
+}
+
+// ListMintRestrictions returns the names of all registered mint restrictions,
+// in the order they are applied.
+func (k EVMSendKeeper) ListMintRestrictions() []string {
+	return k.mintRestriction.list()
+}
+
+// AppendMintRestriction adds the provided MintRestrictionFn to run after previously provided restrictions.
+func (k EVMSendKeeper) AppendMintRestriction(restriction MintRestrictionFn) {
+	k.mintRestriction.append(restriction)
+}
+
+// PrependMintRestriction adds the provided MintRestrictionFn to run before previously provided restrictions.
+func (k EVMSendKeeper) PrependMintRestriction(restriction MintRestrictionFn) {
+	k.mintRestriction.prepend(restriction)
+}
+
+// ClearMintRestriction removes all registered mint restrictions.
+func (k EVMSendKeeper) ClearMintRestriction() {
+	k.mintRestriction.clear()
+}
+
 // GetAuthority returns the x/bank module's authority.
 func (k EVMSendKeeper) GetAuthority() string {
 	return k.authority
@@ -104,15 +174,122 @@ func (k EVMSendKeeper) SetParams(ctx context.Context, params types.Params) error
 	return k.Params.Set(ctx, params)
 }
 
-// InputOutputCoins performs multi-send functionality. It accepts a series of
-// inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
-func (k EVMSendKeeper) InputOutputCoins(ctx context.Context, inputs types.Input, outputs []types.Output) error {
-	return sdkerrors.ErrNotSupported
+// InputOutputCoins performs multi-send functionality. It accepts a single
+// input that corresponds to a series of outputs. It returns an error if any
+// single transfer of tokens fails, reverting the whole operation.
+//
+// Note: like SendCoins, SendEnabled/BlockedAddr gating is intentionally not
+// done here; see msgServer.MultiSend.
+func (k EVMSendKeeper) InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error {
+	if err := validateInputOutputCoins(input, outputs); err != nil {
+		return err
+	}
+
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(input.Address)
+	if err != nil {
+		return err
+	}
+
+	// Run every leg against a cached copy of the state that is written back
+	// only once all legs have succeeded, so a failing leg also undoes the
+	// legs before it.
+	sdkCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	ctx = sdkCtx
+	for _, out := range outputs {
+		toAddr, err := k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+
+		// run restrictions before debiting the sender, matching the ordering
+		// fix in cosmos-sdk #21976.
+		toAddr, err = k.sendRestriction.apply(ctx, fromAddr, toAddr, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.ek.SendCoins(ctx, fromAddr, toAddr, out.Coins); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			types.NewCoinSpentEvent(fromAddr, out.Coins),
+		)
+		sdkCtx.EventManager().EmitEvent(
+			types.NewCoinReceivedEvent(toAddr, out.Coins),
+		)
+
+		// Create account if recipient does not exist.
+		accExists := k.ak.HasAccount(ctx, toAddr)
+		if !accExists {
+			defer telemetry.IncrCounter(1, "new", "account")
+			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			newTransferEvent(input.Address, toAddr, out.Coins),
+		)
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, input.Address),
+		),
+	)
+	writeCache()
+
+	return nil
+}
+
+// newTransferEvent builds the sdk.EventTypeTransfer event emitted for a
+// single sender/recipient/amount leg of a transfer. toAddr is the resolved
+// recipient, after any send restrictions have run, so that the event agrees
+// with the CoinReceivedEvent about who actually received the funds even when
+// a restriction rewrote the original recipient.
+func newTransferEvent(fromAddr string, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySender, fromAddr),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// validateInputOutputCoins checks that input and each output are individually
+// well-formed and that the coins being debited from input exactly match the
+// sum of what's credited across outputs. Without this invariant, a caller
+// (e.g. x/authz's SendAuthorization, which only inspects Input.Coins to
+// enforce its spend limit) could under-report Input.Coins while over-crediting
+// via Outputs.
+func validateInputOutputCoins(input types.Input, outputs []types.Output) error {
+	if err := input.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var outputsSum sdk.Coins
+	for _, out := range outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+		outputsSum = outputsSum.Add(out.Coins...)
+	}
+
+	if !input.Coins.Equal(outputsSum) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("sum of outputs (%s) does not equal input coins (%s)", outputsSum, input.Coins)
+	}
+
+	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
+//
+// Note: SendEnabled/BlockedAddr gating is intentionally not done here. SendCoins
+// is also the path used for internal, non-user-initiated transfers (fee
+// distribution, IBC escrow movements, refunds to module accounts that are
+// deliberately in BlockedAddr), so those checks belong in the message server
+// handler (see msgServer.Send), matching upstream cosmos-sdk.
 func (k EVMSendKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
 	if err != nil {
@@ -147,12 +324,7 @@ func (k EVMSendKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, t
 	}
 
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeTransfer,
-			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-		),
+		newTransferEvent(fromAddr.String(), toAddr, amt),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
@@ -165,6 +337,11 @@ func (k EVMSendKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, t
 // initBalances sets the balance (multiple coins) for an account by address.
 // An error is returned upon failure.
 func (k EVMSendKeeper) initBalances(ctx context.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	addr, balances, err := k.mintRestriction.apply(ctx, addr, balances)
+	if err != nil {
+		return err
+	}
+
 	return k.ek.MintCoins(ctx, addr, balances)
 }
 
@@ -296,40 +473,285 @@ func (k EVMSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom string
 	return defaultVal
 }
 
-// sendRestriction is a struct that houses a SendRestrictionFn.
-// It exists so that the SendRestrictionFn can be updated in the SendKeeper without needing to have a pointer receiver.
+// sendRestriction is a registry of named SendRestrictionFns, modeled on
+// cosmos-sdk bank/v2's global send restriction design. Restrictions are kept by
+// name so that multiple modules (e.g. a compliance module, an ERC20 blocklist
+// module, a rate-limiter) can register their own without clobbering one
+// another, and are combined, in order, into the function applied by SendCoins
+// and InputOutputCoins.
+//
+// It exists as its own type (rather than living directly on EVMSendKeeper) so
+// that it can be mutated without needing a pointer receiver on the keeper.
 type sendRestriction struct {
-	fn types.SendRestrictionFn
+	restrictions map[string]types.SendRestrictionFn
+	names        []string // registered names; sorted by applyOrder when no explicit order is set
+	order        []string // explicit apply order; alphabetical by name when empty
+	anonymous    int
 }
 
-// newSendRestriction creates a new sendRestriction with nil send restriction.
+// newSendRestriction creates a new, empty sendRestriction registry.
 func newSendRestriction() *sendRestriction {
 	return &sendRestriction{
-		fn: nil,
+		restrictions: make(map[string]types.SendRestrictionFn),
+	}
+}
+
+// register adds or replaces the named restriction in the registry.
+func (r *sendRestriction) register(name string, fn types.SendRestrictionFn) {
+	if _, exists := r.restrictions[name]; !exists {
+		r.names = append(r.names, name)
 	}
+	r.restrictions[name] = fn
+}
+
+// setOrder sets the explicit order in which registered restrictions are
+// applied. Passing nil restores the default: alphabetical order of names.
+func (r *sendRestriction) setOrder(order []string) {
+	r.order = order
+}
+
+// list returns the names of all registered restrictions, in apply order.
+func (r *sendRestriction) list() []string {
+	return r.applyOrder()
 }
 
-// append adds the provided restriction to this, to be run after the existing function.
+// applyOrder returns the order restrictions are applied in: the explicit
+// order if one was set, otherwise alphabetical order of registered names. Any
+// registered name missing from an explicit order (e.g. one registered after
+// SetSendRestrictionOrder was called) is appended, alphabetically, at the
+// end, so that registering a restriction can never silently drop it from the
+// apply chain.
+func (r *sendRestriction) applyOrder() []string {
+	if len(r.order) == 0 {
+		order := make([]string, len(r.names))
+		copy(order, r.names)
+		sort.Strings(order)
+		return order
+	}
+
+	order := make([]string, 0, len(r.restrictions))
+	listed := make(map[string]bool, len(r.order))
+	for _, name := range r.order {
+		if _, ok := r.restrictions[name]; !ok {
+			continue
+		}
+		order = append(order, name)
+		listed[name] = true
+	}
+
+	var unlisted []string
+	for name := range r.restrictions {
+		if !listed[name] {
+			unlisted = append(unlisted, name)
+		}
+	}
+	sort.Strings(unlisted)
+
+	return append(order, unlisted...)
+}
+
+// append adds the provided restriction to run after previously registered
+// restrictions, for backward compatibility with the pre-registry API. It is
+// registered under a generated name and pinned to run last.
 func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
-	r.fn = r.fn.Then(restriction)
+	// Snapshot the order before registering, since registering a new name
+	// makes applyOrder() include it already (see applyOrder's unlisted-name
+	// handling) — appending it again here would run it twice.
+	order := r.applyOrder()
+	name := r.nextAnonymousName()
+	r.register(name, restriction)
+	r.order = append(order, name)
 }
 
-// prepend adds the provided restriction to this, to be run before the existing function.
+// prepend adds the provided restriction to run before previously registered
+// restrictions, for backward compatibility with the pre-registry API. It is
+// registered under a generated name and pinned to run first.
 func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
-	r.fn = restriction.Then(r.fn)
+	// See the comment in append about snapshotting the order first.
+	order := r.applyOrder()
+	name := r.nextAnonymousName()
+	r.register(name, restriction)
+	r.order = append([]string{name}, order...)
 }
 
-// clear removes the send restriction (sets it to nil).
+// clear removes all registered restrictions.
 func (r *sendRestriction) clear() {
-	r.fn = nil
+	r.restrictions = make(map[string]types.SendRestrictionFn)
+	r.names = nil
+	r.order = nil
+	r.anonymous = 0
+}
+
+// nextAnonymousName returns a unique name for a restriction registered
+// through the legacy Append/PrependSendRestriction methods.
+func (r *sendRestriction) nextAnonymousName() string {
+	r.anonymous++
+	return fmt.Sprintf("legacy#%d", r.anonymous)
 }
 
 var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
 
-// apply applies the send restriction if there is one. If not, it's a no-op.
+// apply runs the registered restrictions, in order, threading the (possibly
+// rewritten) recipient address through each. If none are registered, it's a
+// no-op.
 func (r *sendRestriction) apply(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
-	if r == nil || r.fn == nil {
+	if r == nil {
 		return toAddr, nil
 	}
-	return r.fn(ctx, fromAddr, toAddr, amt)
+
+	var err error
+	for _, name := range r.applyOrder() {
+		fn, ok := r.restrictions[name]
+		if !ok || fn == nil {
+			continue
+		}
+		if toAddr, err = fn(ctx, fromAddr, toAddr, amt); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// MintRestrictionFn can be used to control or rewrite a mint dispatched
+// through the ERC20 keeper, e.g. from initBalances. It mirrors
+// types.SendRestrictionFn, but operates on the single (addr, coins) pair
+// being minted rather than a sender/recipient pair. This mirrors the
+// MintingRestrictionFn idea introduced alongside send restrictions in
+// cosmos-sdk #14224.
+type MintRestrictionFn func(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, sdk.Coins, error)
+
+// mintRestriction is a registry of named MintRestrictionFns, kept by name for
+// the same reason as sendRestriction: so that multiple modules can register
+// their own mint restriction without clobbering one another, combined, in
+// order, into the function applied from initBalances.
+//
+// It exists as its own type (rather than living directly on EVMSendKeeper) so
+// that it can be mutated without needing a pointer receiver on the keeper.
+type mintRestriction struct {
+	restrictions map[string]MintRestrictionFn
+	names        []string // registered names; sorted by applyOrder when no explicit order is set
+	order        []string // explicit apply order; alphabetical by name when empty
+	anonymous    int
+}
+
+// newMintRestriction creates a new, empty mintRestriction registry.
+func newMintRestriction() *mintRestriction {
+	return &mintRestriction{
+		restrictions: make(map[string]MintRestrictionFn),
+	}
+}
+
+// register adds or replaces the named restriction in the registry.
+func (r *mintRestriction) register(name string, fn MintRestrictionFn) {
+	if _, exists := r.restrictions[name]; !exists {
+		r.names = append(r.names, name)
+	}
+	r.restrictions[name] = fn
+}
+
+// setOrder sets the explicit order in which registered restrictions are
+// applied. Passing nil restores the default: alphabetical order of names.
+func (r *mintRestriction) setOrder(order []string) {
+	r.order = order
+}
+
+// list returns the names of all registered restrictions, in apply order.
+func (r *mintRestriction) list() []string {
+	return r.applyOrder()
+}
+
+// applyOrder returns the order restrictions are applied in: the explicit
+// order if one was set, otherwise alphabetical order of registered names. Any
+// registered name missing from an explicit order is appended, alphabetically,
+// at the end, so that registering a restriction can never silently drop it
+// from the apply chain.
+func (r *mintRestriction) applyOrder() []string {
+	if len(r.order) == 0 {
+		order := make([]string, len(r.names))
+		copy(order, r.names)
+		sort.Strings(order)
+		return order
+	}
+
+	order := make([]string, 0, len(r.restrictions))
+	listed := make(map[string]bool, len(r.order))
+	for _, name := range r.order {
+		if _, ok := r.restrictions[name]; !ok {
+			continue
+		}
+		order = append(order, name)
+		listed[name] = true
+	}
+
+	var unlisted []string
+	for name := range r.restrictions {
+		if !listed[name] {
+			unlisted = append(unlisted, name)
+		}
+	}
+	sort.Strings(unlisted)
+
+	return append(order, unlisted...)
+}
+
+// append adds the provided restriction to run after previously registered
+// restrictions, for backward compatibility with the pre-registry API. It is
+// registered under a generated name and pinned to run last.
+func (r *mintRestriction) append(restriction MintRestrictionFn) {
+	// Snapshot the order before registering, since registering a new name
+	// makes applyOrder() include it already (see applyOrder's unlisted-name
+	// handling) — appending it again here would run it twice.
+	order := r.applyOrder()
+	name := r.nextAnonymousName()
+	r.register(name, restriction)
+	r.order = append(order, name)
+}
+
+// prepend adds the provided restriction to run before previously registered
+// restrictions, for backward compatibility with the pre-registry API. It is
+// registered under a generated name and pinned to run first.
+func (r *mintRestriction) prepend(restriction MintRestrictionFn) {
+	// See the comment in append about snapshotting the order first.
+	order := r.applyOrder()
+	name := r.nextAnonymousName()
+	r.register(name, restriction)
+	r.order = append([]string{name}, order...)
+}
+
+// clear removes all registered restrictions.
+func (r *mintRestriction) clear() {
+	r.restrictions = make(map[string]MintRestrictionFn)
+	r.names = nil
+	r.order = nil
+	r.anonymous = 0
+}
+
+// nextAnonymousName returns a unique name for a restriction registered
+// through the legacy Append/PrependMintRestriction methods.
+func (r *mintRestriction) nextAnonymousName() string {
+	r.anonymous++
+	return fmt.Sprintf("legacy#%d", r.anonymous)
+}
+
+// apply runs the registered restrictions, in order, threading the (possibly
+// rewritten) address and coins through each. If none are registered, it's a
+// no-op.
+func (r *mintRestriction) apply(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+	if r == nil {
+		return addr, coins, nil
+	}
+
+	var err error
+	for _, name := range r.applyOrder() {
+		fn, ok := r.restrictions[name]
+		if !ok || fn == nil {
+			continue
+		}
+		if addr, coins, err = fn(ctx, addr, coins); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return addr, coins, nil
 }
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateInputOutputCoins(t *testing.T) {
+	fromAddr := sdk.AccAddress(append(make([]byte, 19), 1)).String()
+	toAddr1 := sdk.AccAddress(append(make([]byte, 19), 2)).String()
+	toAddr2 := sdk.AccAddress(append(make([]byte, 19), 3)).String()
+
+	input := types.Input{Address: fromAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 100))}
+	outputs := []types.Output{
+		{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 60))},
+		{Address: toAddr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 40))},
+	}
+
+	require.NoError(t, validateInputOutputCoins(input, outputs))
+
+	// A grantee could under-report Input.Coins (what x/authz's
+	// SendAuthorization checks) while over-crediting via Outputs; this must
+	// be rejected rather than silently executed.
+	underReportedInput := types.Input{Address: fromAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 1))}
+	require.Error(t, validateInputOutputCoins(underReportedInput, outputs))
+
+	tooFewOutputs := []types.Output{
+		{Address: toAddr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 60))},
+	}
+	require.Error(t, validateInputOutputCoins(input, tooFewOutputs))
+
+	invalidOutput := []types.Output{
+		{Address: "not-a-bech32-address", Coins: sdk.NewCoins(sdk.NewInt64Coin("foo", 100))},
+	}
+	require.Error(t, validateInputOutputCoins(input, invalidOutput))
+}
+
+func TestSendRestrictionApplyOrder(t *testing.T) {
+	r := newSendRestriction()
+	r.register("b", func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		return to, nil
+	})
+	r.register("a", func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		return to, nil
+	})
+
+	// No explicit order: alphabetical by name.
+	require.Equal(t, []string{"a", "b"}, r.applyOrder())
+
+	r.setOrder([]string{"b", "a"})
+	require.Equal(t, []string{"b", "a"}, r.applyOrder())
+
+	// A restriction registered under a name missing from the explicit order
+	// must still run (alphabetically, after the pinned names), rather than
+	// being silently dropped.
+	r.register("c", func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		return to, nil
+	})
+	require.Equal(t, []string{"b", "a", "c"}, r.applyOrder())
+}
+
+func TestSendRestrictionAppendPrependDoNotDuplicate(t *testing.T) {
+	var calls []string
+	r := newSendRestriction()
+	r.append(func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "first")
+		return to, nil
+	})
+	r.append(func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "second")
+		return to, nil
+	})
+	r.prepend(func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "zeroth")
+		return to, nil
+	})
+
+	fromAddr := sdk.AccAddress(append(make([]byte, 19), 1))
+	toAddr := sdk.AccAddress(append(make([]byte, 19), 2))
+
+	_, err := r.apply(context.Background(), fromAddr, toAddr, sdk.NewCoins())
+	require.NoError(t, err)
+	// Each restriction must run exactly once, in append/prepend order.
+	require.Equal(t, []string{"zeroth", "first", "second"}, calls)
+}
+
+func TestSendRestrictionApply(t *testing.T) {
+	var calls []string
+	r := newSendRestriction()
+	r.register("b", func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "b")
+		return to, nil
+	})
+	r.register("a", func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "a")
+		return to, nil
+	})
+
+	fromAddr := sdk.AccAddress(append(make([]byte, 19), 1))
+	toAddr := sdk.AccAddress(append(make([]byte, 19), 2))
+
+	got, err := r.apply(context.Background(), fromAddr, toAddr, sdk.NewCoins())
+	require.NoError(t, err)
+	require.Equal(t, toAddr, got)
+	require.Equal(t, []string{"a", "b"}, calls)
+}
+
+func TestNewTransferEvent(t *testing.T) {
+	fromAddr := sdk.AccAddress(append(make([]byte, 19), 1)).String()
+	toAddr := sdk.AccAddress(append(make([]byte, 19), 2))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	// The event must report the resolved recipient address (toAddr), not
+	// whatever address was originally requested, so that it agrees with the
+	// CoinReceivedEvent even when a send restriction rewrote the recipient.
+	event := newTransferEvent(fromAddr, toAddr, coins)
+	require.Equal(t, types.EventTypeTransfer, event.Type)
+
+	got := make(map[string]string, len(event.Attributes))
+	for _, a := range event.Attributes {
+		got[a.Key] = a.Value
+	}
+	require.Equal(t, toAddr.String(), got[types.AttributeKeyRecipient])
+	require.Equal(t, fromAddr, got[types.AttributeKeySender])
+	require.Equal(t, coins.String(), got[sdk.AttributeKeyAmount])
+}
+
+func TestBlockedAddr(t *testing.T) {
+	blocked := sdk.AccAddress(append(make([]byte, 19), 1))
+	allowed := sdk.AccAddress(append(make([]byte, 19), 2))
+
+	k := EVMSendKeeper{blockedAddrs: map[string]bool{blocked.String(): true}}
+
+	require.True(t, k.BlockedAddr(blocked))
+	require.False(t, k.BlockedAddr(allowed))
+}
+
+func TestMintRestrictionOrder(t *testing.T) {
+	var calls []string
+	r := newMintRestriction()
+	r.append(func(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+		calls = append(calls, "first")
+		return addr, coins, nil
+	})
+	r.append(func(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+		calls = append(calls, "second")
+		return addr, coins, nil
+	})
+	r.prepend(func(ctx context.Context, addr sdk.AccAddress, coins sdk.Coins) (sdk.AccAddress, sdk.Coins, error) {
+		calls = append(calls, "zeroth")
+		return addr, coins, nil
+	})
+
+	addr := sdk.AccAddress(append(make([]byte, 19), 1))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+
+	gotAddr, gotCoins, err := r.apply(context.Background(), addr, coins)
+	require.NoError(t, err)
+	require.Equal(t, addr, gotAddr)
+	require.Equal(t, coins, gotCoins)
+	require.Equal(t, []string{"zeroth", "first", "second"}, calls)
+
+	r.clear()
+	calls = nil
+	_, _, err = r.apply(context.Background(), addr, coins)
+	require.NoError(t, err)
+	require.Empty(t, calls)
+}